@@ -1,15 +1,16 @@
 package adminclient
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strings"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
 type Client interface {
@@ -17,6 +18,12 @@ type Client interface {
 	CreateIdentityProvider(ctx context.Context, template *IdentityProviderRequest) (*IdentityProviderResponse, error)
 	UpdateIdentityProvider(ctx context.Context, id string, template *IdentityProviderRequest) (*IdentityProviderResponse, error)
 	DeleteIdentityProvider(ctx context.Context, id string) error
+
+	ListDomains(ctx context.Context, providerID string) ([]Domain, error)
+	AddDomain(ctx context.Context, providerID string, domain string) (*Domain, error)
+	RemoveDomain(ctx context.Context, providerID string, domain string) error
+
+	ListIdentityProviders(ctx context.Context, filter ListIdentityProvidersFilter) ([]IdentityProviderResponse, error)
 }
 
 type HTTPClient interface {
@@ -27,6 +34,13 @@ type client struct {
 	HTTPClient HTTPClient
 	BaseURL    url.URL
 	Headers    http.Header
+
+	retryMax          int
+	retryBaseDelay    time.Duration
+	retryCapDelay     time.Duration
+	retryableStatuses map[int]bool
+
+	rateLimiter *rate.Limiter
 }
 
 type Option = func(*client)
@@ -42,6 +56,10 @@ func New(options ...Option) (Client, error) {
 		c.HTTPClient = http.DefaultClient
 	}
 
+	if c.rateLimiter != nil {
+		c.HTTPClient = &rateLimitedHTTPClient{HTTPClient: c.HTTPClient, limiter: c.rateLimiter}
+	}
+
 	if c.Headers == nil {
 		c.Headers = make(http.Header)
 	}
@@ -89,6 +107,13 @@ type IdentityProviderRequest struct {
 	MetadataURL      string           `json:"metadata_url,omitempty"`
 	AttributeMapping AttributeMapping `json:"attribute_mapping,omitempty"`
 
+	OIDCIssuer       string   `json:"oidc_issuer,omitempty"`
+	OIDCClientID     string   `json:"oidc_client_id,omitempty"`
+	OIDCClientSecret string   `json:"oidc_client_secret,omitempty"`
+	OIDCScopes       []string `json:"oidc_scopes,omitempty"`
+	OIDCDiscoveryURL string   `json:"oidc_discovery_url,omitempty"`
+	OIDCJWKSURL      string   `json:"oidc_jwks_url,omitempty"`
+
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 }
@@ -100,6 +125,7 @@ type IdentityProviderResponse struct {
 	Domains []Domain `json:"domains,omitempty"`
 
 	SAML SAML `json:"saml,omitempty"`
+	OIDC OIDC `json:"oidc,omitempty"`
 
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
@@ -111,35 +137,36 @@ type SAML struct {
 	AttributeMapping AttributeMapping `json:"attribute_mapping,omitempty"`
 }
 
+type OIDC struct {
+	Issuer           string           `json:"issuer"`
+	ClientID         string           `json:"client_id"`
+	Scopes           []string         `json:"scopes,omitempty"`
+	DiscoveryURL     string           `json:"discovery_url,omitempty"`
+	JWKSURL          string           `json:"jwks_url,omitempty"`
+	AttributeMapping AttributeMapping `json:"attribute_mapping,omitempty"`
+}
+
 type Domain struct {
-	Domain string `json:"domain,omitempty"`
+	ID         string `json:"id,omitempty"`
+	ProviderID string `json:"sso_provider_id,omitempty"`
+	Domain     string `json:"domain,omitempty"`
+
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
 }
 
 func (c *client) GetIdentityProvider(ctx context.Context, id string) (*IdentityProviderResponse, error) {
 	url := c.BaseURL
 	url.Path += "/admin/sso/providers/" + id
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header = c.Headers.Clone()
-
-	res, err := c.HTTPClient.Do(req)
+	result, err := c.doRequest(ctx, http.MethodGet, url.String(), nil, true, fmt.Sprintf("fetching identity provider with id %q", id), http.StatusOK)
 	if err != nil {
 		return nil, err
 	}
 
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		return nil, parseError(res, http.StatusOK, fmt.Sprintf("fetching identity provider with id %q", id))
-	}
-
 	provider := &IdentityProviderResponse{}
 
-	if err := json.NewDecoder(res.Body).Decode(provider); err != nil {
+	if err := json.Unmarshal(result.Body, provider); err != nil {
 		return nil, err
 	}
 
@@ -150,33 +177,19 @@ func (c *client) CreateIdentityProvider(ctx context.Context, template *IdentityP
 	url := c.BaseURL
 	url.Path += "/admin/sso/providers"
 
-	buffer := bytes.NewBuffer(make([]byte, 0))
-	if err := json.NewEncoder(buffer).Encode(template); err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), buffer)
+	body, err := json.Marshal(template)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header = c.Headers.Clone()
-	req.Header.Add("Content-Type", "application/json")
-
-	res, err := c.HTTPClient.Do(req)
+	result, err := c.doRequest(ctx, http.MethodPost, url.String(), body, false, "creating new identity provider", http.StatusCreated)
 	if err != nil {
 		return nil, err
 	}
 
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusCreated {
-		return nil, parseError(res, http.StatusCreated, "creating new identity provider")
-	}
-
 	provider := &IdentityProviderResponse{}
 
-	if err := json.NewDecoder(res.Body).Decode(provider); err != nil {
+	if err := json.Unmarshal(result.Body, provider); err != nil {
 		return nil, err
 	}
 
@@ -187,33 +200,19 @@ func (c *client) UpdateIdentityProvider(ctx context.Context, id string, template
 	url := c.BaseURL
 	url.Path += "/admin/sso/providers/" + id
 
-	buffer := bytes.NewBuffer(make([]byte, 0))
-	if err := json.NewEncoder(buffer).Encode(template); err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url.String(), buffer)
+	body, err := json.Marshal(template)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header = c.Headers.Clone()
-	req.Header.Add("Content-Type", "application/json")
-
-	res, err := c.HTTPClient.Do(req)
+	result, err := c.doRequest(ctx, http.MethodPut, url.String(), body, true, fmt.Sprintf("updating identity provider with ID %q", id), http.StatusOK)
 	if err != nil {
 		return nil, err
 	}
 
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		return nil, parseError(res, http.StatusOK, fmt.Sprintf("updating identity provider with ID %q", id))
-	}
-
 	provider := &IdentityProviderResponse{}
 
-	if err := json.NewDecoder(res.Body).Decode(provider); err != nil {
+	if err := json.Unmarshal(result.Body, provider); err != nil {
 		return nil, err
 	}
 
@@ -224,25 +223,9 @@ func (c *client) DeleteIdentityProvider(ctx context.Context, id string) error {
 	url := c.BaseURL
 	url.Path += "/admin/sso/providers/" + id
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url.String(), nil)
-	if err != nil {
-		return err
-	}
-
-	req.Header = c.Headers.Clone()
-
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return err
-	}
-
-	defer res.Body.Close()
+	_, err := c.doRequest(ctx, http.MethodDelete, url.String(), nil, true, fmt.Sprintf("deleting identity provider with ID %q", id), http.StatusOK)
 
-	if res.StatusCode != http.StatusOK {
-		return parseError(res, http.StatusOK, fmt.Sprintf("deleting identity provider with ID %q", id))
-	}
-
-	return nil
+	return err
 }
 
 type Error struct {
@@ -258,12 +241,37 @@ func (e *Error) Error() string {
 	return fmt.Sprintf("adminclient: expected HTTP %v when %s, got HTTP %v: %s", e.Expected, e.Op, e.Code, e.Message)
 }
 
-func parseError(res *http.Response, expected int, op string) error {
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return err
+// Sentinel errors for the GoTrue admin API failure modes that resource CRUD
+// functions need to react to specifically, rather than surface opaquely.
+// Match them with errors.Is, e.g. errors.Is(err, adminclient.ErrProviderNotFound).
+var (
+	ErrDomainConflict   = errors.New("adminclient: domain is already claimed by another identity provider")
+	ErrProviderNotFound = errors.New("adminclient: identity provider not found")
+	ErrInvalidMetadata  = errors.New("adminclient: metadata failed validation")
+	ErrUnauthorized     = errors.New("adminclient: request was not authorized")
+	ErrRateLimited      = errors.New("adminclient: request was rate limited")
+)
+
+// Is implements errors.Is support, classifying the underlying GoTrue
+// error_id/code into one of the sentinel errors above.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrProviderNotFound:
+		return e.Code == http.StatusNotFound || e.ErrorID == "sso_provider_not_found"
+	case ErrUnauthorized:
+		return e.Code == http.StatusUnauthorized || e.Code == http.StatusForbidden || e.ErrorID == "unauthorized"
+	case ErrRateLimited:
+		return e.Code == http.StatusTooManyRequests || e.ErrorID == "over_request_rate_limit"
+	case ErrDomainConflict:
+		return e.ErrorID == "sso_domain_already_exists" || e.ErrorID == "domain_already_exists"
+	case ErrInvalidMetadata:
+		return e.ErrorID == "invalid_saml_metadata" || e.ErrorID == "invalid_metadata"
+	default:
+		return false
 	}
+}
 
+func parseError(res *http.Response, body []byte, expected int, op string) error {
 	var errorObject Error
 	errorObject.Op = op
 	errorObject.Expected = expected
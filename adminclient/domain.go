@@ -0,0 +1,66 @@
+package adminclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type domainRequest struct {
+	Domain string `json:"domain"`
+}
+
+type listDomainsResponse struct {
+	Domains []Domain `json:"domains,omitempty"`
+}
+
+func (c *client) ListDomains(ctx context.Context, providerID string) ([]Domain, error) {
+	url := c.BaseURL
+	url.Path += "/admin/sso/providers/" + providerID + "/domains"
+
+	result, err := c.doRequest(ctx, http.MethodGet, url.String(), nil, true, fmt.Sprintf("listing domains for identity provider with id %q", providerID), http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &listDomainsResponse{}
+
+	if err := json.Unmarshal(result.Body, list); err != nil {
+		return nil, err
+	}
+
+	return list.Domains, nil
+}
+
+func (c *client) AddDomain(ctx context.Context, providerID string, domain string) (*Domain, error) {
+	url := c.BaseURL
+	url.Path += "/admin/sso/providers/" + providerID + "/domains"
+
+	body, err := json.Marshal(&domainRequest{Domain: domain})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.doRequest(ctx, http.MethodPost, url.String(), body, false, fmt.Sprintf("adding domain %q to identity provider with id %q", domain, providerID), http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	added := &Domain{}
+
+	if err := json.Unmarshal(result.Body, added); err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+func (c *client) RemoveDomain(ctx context.Context, providerID string, domain string) error {
+	url := c.BaseURL
+	url.Path += "/admin/sso/providers/" + providerID + "/domains/" + domain
+
+	_, err := c.doRequest(ctx, http.MethodDelete, url.String(), nil, true, fmt.Sprintf("removing domain %q from identity provider with id %q", domain, providerID), http.StatusOK)
+
+	return err
+}
@@ -0,0 +1,55 @@
+package adminclient
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestErrorIsClassification(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    *Error
+		target error
+		want   bool
+	}{
+		{"not found by code", &Error{Code: http.StatusNotFound}, ErrProviderNotFound, true},
+		{"not found by error_id", &Error{ErrorID: "sso_provider_not_found"}, ErrProviderNotFound, true},
+		{"not found mismatch", &Error{Code: http.StatusBadRequest}, ErrProviderNotFound, false},
+
+		{"unauthorized by code", &Error{Code: http.StatusUnauthorized}, ErrUnauthorized, true},
+		{"forbidden by code", &Error{Code: http.StatusForbidden}, ErrUnauthorized, true},
+		{"unauthorized by error_id", &Error{ErrorID: "unauthorized"}, ErrUnauthorized, true},
+		{"unauthorized mismatch", &Error{Code: http.StatusOK}, ErrUnauthorized, false},
+
+		{"rate limited by code", &Error{Code: http.StatusTooManyRequests}, ErrRateLimited, true},
+		{"rate limited by error_id", &Error{ErrorID: "over_request_rate_limit"}, ErrRateLimited, true},
+		{"rate limited mismatch", &Error{Code: http.StatusServiceUnavailable}, ErrRateLimited, false},
+
+		{"domain conflict by error_id", &Error{ErrorID: "sso_domain_already_exists"}, ErrDomainConflict, true},
+		{"domain conflict alternate error_id", &Error{ErrorID: "domain_already_exists"}, ErrDomainConflict, true},
+		{"domain conflict mismatch", &Error{Code: http.StatusConflict}, ErrDomainConflict, false},
+
+		{"invalid metadata by error_id", &Error{ErrorID: "invalid_saml_metadata"}, ErrInvalidMetadata, true},
+		{"invalid metadata mismatch", &Error{ErrorID: "something_else"}, ErrInvalidMetadata, false},
+
+		{"unrelated sentinel never matches", &Error{Code: http.StatusNotFound}, ErrRateLimited, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(tt.err, tt.target); got != tt.want {
+				t.Fatalf("errors.Is(%+v, %v) = %v, want %v", tt.err, tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorIsUnwrapsThroughRetryError(t *testing.T) {
+	underlying := &Error{Code: http.StatusNotFound}
+	wrapped := &RetryError{Attempts: 3, Err: underlying}
+
+	if !errors.Is(wrapped, ErrProviderNotFound) {
+		t.Fatal("expected errors.Is to unwrap RetryError and match the sentinel on the underlying *Error")
+	}
+}
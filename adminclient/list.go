@@ -0,0 +1,46 @@
+package adminclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+type ListIdentityProvidersFilter struct {
+	Domain     string
+	ResourceID string
+}
+
+type listIdentityProvidersResponse struct {
+	Items []IdentityProviderResponse `json:"items,omitempty"`
+}
+
+func (c *client) ListIdentityProviders(ctx context.Context, filter ListIdentityProvidersFilter) ([]IdentityProviderResponse, error) {
+	url := c.BaseURL
+	url.Path += "/admin/sso/providers"
+
+	query := url.Query()
+
+	if filter.Domain != "" {
+		query.Set("domain", filter.Domain)
+	}
+
+	if filter.ResourceID != "" {
+		query.Set("resource_id", filter.ResourceID)
+	}
+
+	url.RawQuery = query.Encode()
+
+	result, err := c.doRequest(ctx, http.MethodGet, url.String(), nil, true, "listing identity providers", http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &listIdentityProvidersResponse{}
+
+	if err := json.Unmarshal(result.Body, list); err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
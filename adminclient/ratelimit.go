@@ -0,0 +1,31 @@
+package adminclient
+
+import (
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// WithRateLimit caps outgoing admin API requests to rps requests per second,
+// allowing bursts of up to burst requests, by wrapping the configured
+// HTTPClient in a token-bucket limiter. This lets Terraform operators bound
+// admin traffic (e.g. behind a gateway with tight per-minute limits) without
+// lowering Terraform's own -parallelism globally.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *client) {
+		c.rateLimiter = rate.NewLimiter(rate.Limit(rps), burst)
+	}
+}
+
+type rateLimitedHTTPClient struct {
+	HTTPClient
+	limiter *rate.Limiter
+}
+
+func (c *rateLimitedHTTPClient) Do(r *http.Request) (*http.Response, error) {
+	if err := c.limiter.Wait(r.Context()); err != nil {
+		return nil, err
+	}
+
+	return c.HTTPClient.Do(r)
+}
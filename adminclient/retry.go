@@ -0,0 +1,235 @@
+package adminclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryBaseDelay = 100 * time.Millisecond
+	defaultRetryCapDelay  = 10 * time.Second
+)
+
+var defaultRetryableStatuses = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// WithRetryPolicy enables retries of idempotent requests, up to max attempts
+// beyond the first, using full-jitter exponential backoff bounded by base and
+// cap: sleep = rand(0, min(cap, base * 2^attempt)). A max of 0 (the default)
+// disables retries entirely.
+func WithRetryPolicy(max int, base, cap time.Duration) Option {
+	return func(c *client) {
+		c.retryMax = max
+		c.retryBaseDelay = base
+		c.retryCapDelay = cap
+	}
+}
+
+// WithRetryableStatuses overrides the default set of HTTP status codes
+// (429, 502, 503, 504) that are eligible for retry on idempotent requests.
+func WithRetryableStatuses(statuses ...int) Option {
+	return func(c *client) {
+		retryable := make(map[int]bool, len(statuses))
+
+		for _, status := range statuses {
+			retryable[status] = true
+		}
+
+		c.retryableStatuses = retryable
+	}
+}
+
+// RetryError wraps the last error encountered after a request to the GoTrue
+// admin API was retried at least once. A request that fails on its first
+// attempt returns its error unwrapped.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("adminclient: request failed after %v attempt(s): %v", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+type requestResult struct {
+	Response *http.Response
+	Body     []byte
+}
+
+// doRequest executes method against urlStr, retrying according to the
+// client's configured retry policy. GET/PUT/DELETE requests (idempotent
+// true) are retried on network errors and on the configured retryable
+// statuses; POST requests (idempotent false) are only retried on network
+// errors, never on a non-2xx response. ctx is honored for cancellation
+// between attempts.
+func (c *client) doRequest(ctx context.Context, method, urlStr string, body []byte, idempotent bool, op string, expected int) (*requestResult, error) {
+	retryableStatuses := c.retryableStatuses
+	if retryableStatuses == nil {
+		retryableStatuses = defaultRetryableStatuses
+	}
+
+	base := c.retryBaseDelay
+	if base <= 0 {
+		base = defaultRetryBaseDelay
+	}
+
+	capDelay := c.retryCapDelay
+	if capDelay <= 0 {
+		capDelay = defaultRetryCapDelay
+	}
+
+	var lastErr error
+
+	for attempt := 0; ; attempt++ {
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(body)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, urlStr, reqBody)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header = c.Headers.Clone()
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		res, err := c.HTTPClient.Do(req)
+		if err != nil {
+			lastErr = err
+
+			if attempt >= c.retryMax {
+				return nil, wrapRetryError(attempt, lastErr)
+			}
+
+			if err := c.sleep(ctx, attempt, base, capDelay, nil); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		respBody, err := io.ReadAll(res.Body)
+		res.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if res.StatusCode == expected {
+			return &requestResult{Response: res, Body: respBody}, nil
+		}
+
+		lastErr = parseError(res, respBody, expected, op)
+
+		if !idempotent || !retryableStatuses[res.StatusCode] || attempt >= c.retryMax {
+			return nil, wrapRetryError(attempt, lastErr)
+		}
+
+		if err := c.sleep(ctx, attempt, base, capDelay, retryAfterHeader(res)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func wrapRetryError(attempt int, err error) error {
+	if attempt == 0 {
+		return err
+	}
+
+	return &RetryError{Attempts: attempt + 1, Err: err}
+}
+
+func retryAfterHeader(res *http.Response) http.Header {
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode == http.StatusServiceUnavailable {
+		return res.Header
+	}
+
+	return nil
+}
+
+func (c *client) sleep(ctx context.Context, attempt int, base, capDelay time.Duration, headers http.Header) error {
+	delay := fullJitterBackoff(attempt, base, capDelay)
+
+	if retryAfter, ok := parseRetryAfter(headers.Get("Retry-After")); ok {
+		delay = retryAfter
+	}
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func fullJitterBackoff(attempt int, base, capDelay time.Duration) time.Duration {
+	backoff := base
+
+	for i := 0; i < attempt; i++ {
+		backoff *= 2
+
+		if backoff <= 0 || backoff > capDelay {
+			backoff = capDelay
+			break
+		}
+	}
+
+	if backoff > capDelay {
+		backoff = capDelay
+	}
+
+	if backoff <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if when, err := http.ParseTime(value); err == nil {
+		delay := time.Until(when)
+		if delay < 0 {
+			delay = 0
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,175 @@
+package adminclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFullJitterBackoffWithinBounds(t *testing.T) {
+	base := 100 * time.Millisecond
+	cap := 10 * time.Second
+
+	for attempt := 0; attempt < 10; attempt++ {
+		for i := 0; i < 20; i++ {
+			delay := fullJitterBackoff(attempt, base, cap)
+
+			if delay < 0 || delay > cap {
+				t.Fatalf("attempt %v: delay %v out of bounds [0, %v]", attempt, delay, cap)
+			}
+		}
+	}
+}
+
+func TestFullJitterBackoffCapsOnOverflow(t *testing.T) {
+	// A large attempt count would overflow base*2^attempt well before the
+	// loop terminates without the overflow guard in fullJitterBackoff.
+	delay := fullJitterBackoff(100, time.Nanosecond, time.Second)
+
+	if delay < 0 || delay > time.Second {
+		t.Fatalf("delay %v out of bounds [0, %v]", delay, time.Second)
+	}
+}
+
+func TestFullJitterBackoffZeroBase(t *testing.T) {
+	delay := fullJitterBackoff(0, 0, time.Second)
+
+	if delay != 0 {
+		t.Fatalf("expected 0 delay for zero base, got %v", delay)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	future := time.Now().Add(2 * time.Minute)
+
+	tests := []struct {
+		name      string
+		value     string
+		wantOK    bool
+		wantRange [2]time.Duration
+	}{
+		{"empty", "", false, [2]time.Duration{0, 0}},
+		{"delta seconds", "120", true, [2]time.Duration{120 * time.Second, 120 * time.Second}},
+		{"negative delta seconds", "-5", false, [2]time.Duration{0, 0}},
+		{"invalid", "not-a-date", false, [2]time.Duration{0, 0}},
+		{"http date", future.UTC().Format(http.TimeFormat), true, [2]time.Duration{110 * time.Second, 120 * time.Second}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, ok := parseRetryAfter(tt.value)
+
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+
+			if ok && (delay < tt.wantRange[0] || delay > tt.wantRange[1]) {
+				t.Fatalf("delay %v out of expected range [%v, %v]", delay, tt.wantRange[0], tt.wantRange[1])
+			}
+		})
+	}
+}
+
+type fakeResponse struct {
+	status int
+	body   string
+	err    error
+}
+
+type fakeHTTPClient struct {
+	responses []fakeResponse
+	calls     int
+}
+
+func (f *fakeHTTPClient) Do(r *http.Request) (*http.Response, error) {
+	resp := f.responses[f.calls]
+	f.calls++
+
+	if resp.err != nil {
+		return nil, resp.err
+	}
+
+	return &http.Response{
+		StatusCode: resp.status,
+		Body:       io.NopCloser(strings.NewReader(resp.body)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+func TestDoRequestRetriesIdempotentOnRetryableStatus(t *testing.T) {
+	fake := &fakeHTTPClient{responses: []fakeResponse{
+		{status: http.StatusServiceUnavailable, body: `{"error_id":"over_request_rate_limit"}`},
+		{status: http.StatusOK, body: `{"id":"abc"}`},
+	}}
+
+	c := &client{HTTPClient: fake, Headers: make(http.Header), retryMax: 2}
+
+	result, err := c.doRequest(context.Background(), http.MethodGet, "http://example.test/", nil, true, "test op", http.StatusOK)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if fake.calls != 2 {
+		t.Fatalf("expected 2 calls, got %v", fake.calls)
+	}
+
+	if string(result.Body) != `{"id":"abc"}` {
+		t.Fatalf("unexpected body: %s", result.Body)
+	}
+}
+
+func TestDoRequestDoesNotRetryPostOnStatus(t *testing.T) {
+	fake := &fakeHTTPClient{responses: []fakeResponse{
+		{status: http.StatusServiceUnavailable, body: `{"error_id":"over_request_rate_limit"}`},
+		{status: http.StatusCreated, body: `{"id":"abc"}`},
+	}}
+
+	c := &client{HTTPClient: fake, Headers: make(http.Header), retryMax: 2}
+
+	_, err := c.doRequest(context.Background(), http.MethodPost, "http://example.test/", []byte(`{}`), false, "test op", http.StatusCreated)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+
+	if fake.calls != 1 {
+		t.Fatalf("expected exactly 1 call (no status-based retry for POST), got %v", fake.calls)
+	}
+}
+
+func TestDoRequestRetriesNetworkErrorThenFails(t *testing.T) {
+	networkErr := errors.New("connection reset")
+
+	fake := &fakeHTTPClient{responses: []fakeResponse{
+		{err: networkErr},
+		{err: networkErr},
+		{err: networkErr},
+	}}
+
+	c := &client{HTTPClient: fake, Headers: make(http.Header), retryMax: 2}
+
+	_, err := c.doRequest(context.Background(), http.MethodPost, "http://example.test/", []byte(`{}`), false, "test op", http.StatusCreated)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+
+	if fake.calls != 3 {
+		t.Fatalf("expected 3 calls (initial + 2 retries), got %v", fake.calls)
+	}
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("expected a *RetryError, got %T: %v", err, err)
+	}
+
+	if retryErr.Attempts != 3 {
+		t.Fatalf("expected Attempts = 3, got %v", retryErr.Attempts)
+	}
+
+	if !errors.Is(err, networkErr) {
+		t.Fatal("expected errors.Is to unwrap to the underlying network error")
+	}
+}
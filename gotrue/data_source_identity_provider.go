@@ -0,0 +1,39 @@
+package gotrue
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/supabase-community/terraform-provider-gotrue/adminclient"
+)
+
+// dataSourceFindIdentityProvider resolves the single identity provider matching
+// the "domain" or "resource_id" filter attributes on d, returning a diagnostic
+// if zero or more than one provider matches.
+func dataSourceFindIdentityProvider(ctx context.Context, client adminclient.Client, d *schema.ResourceData) (*adminclient.IdentityProviderResponse, diag.Diagnostics) {
+	filter := adminclient.ListIdentityProvidersFilter{
+		Domain:     d.Get("domain").(string),
+		ResourceID: d.Get("resource_id").(string),
+	}
+
+	if filter.Domain == "" && filter.ResourceID == "" {
+		return nil, diag.Errorf("one of \"domain\" or \"resource_id\" must be set")
+	}
+
+	providers, err := client.ListIdentityProviders(ctx, filter)
+	if err != nil {
+		return nil, diagFromClientError(err)
+	}
+
+	if len(providers) == 0 {
+		return nil, diag.Errorf("no identity provider matched the given filter")
+	}
+
+	if len(providers) > 1 {
+		return nil, diag.Errorf("%v identity providers matched the given filter, expected exactly one", len(providers))
+	}
+
+	return &providers[0], nil
+}
@@ -0,0 +1,61 @@
+package gotrue
+
+import (
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/supabase-community/terraform-provider-gotrue/adminclient"
+)
+
+// diagFromClientError translates known adminclient sentinel errors into an
+// actionable diagnostic, falling back to diag.FromErr for anything else.
+func diagFromClientError(err error) diag.Diagnostics {
+	switch {
+	case errors.Is(err, adminclient.ErrDomainConflict):
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Domain is already claimed by another identity provider",
+			Detail:   err.Error(),
+		}}
+	case errors.Is(err, adminclient.ErrProviderNotFound):
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Identity provider not found",
+			Detail:   err.Error(),
+		}}
+	case errors.Is(err, adminclient.ErrInvalidMetadata):
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Identity provider metadata failed validation",
+			Detail:   err.Error(),
+		}}
+	case errors.Is(err, adminclient.ErrUnauthorized):
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Not authorized to manage GoTrue SSO configuration",
+			Detail:   err.Error(),
+		}}
+	case errors.Is(err, adminclient.ErrRateLimited):
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Request was rate limited by the GoTrue admin API",
+			Detail:   err.Error(),
+		}}
+	default:
+		return diag.FromErr(err)
+	}
+}
+
+// diagFromReadError is diagFromClientError for ReadContext functions: when
+// the resource was deleted out-of-band it is dropped from state rather than
+// surfaced as an error, which is the standard Terraform pattern.
+func diagFromReadError(err error, d *schema.ResourceData) diag.Diagnostics {
+	if errors.Is(err, adminclient.ErrProviderNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	return diagFromClientError(err)
+}
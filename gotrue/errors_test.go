@@ -0,0 +1,81 @@
+package gotrue
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/supabase-community/terraform-provider-gotrue/adminclient"
+)
+
+func TestDiagFromClientErrorClassification(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantSummary string
+	}{
+		{"domain conflict", adminclient.ErrDomainConflict, "Domain is already claimed by another identity provider"},
+		{"provider not found", adminclient.ErrProviderNotFound, "Identity provider not found"},
+		{"invalid metadata", adminclient.ErrInvalidMetadata, "Identity provider metadata failed validation"},
+		{"unauthorized", adminclient.ErrUnauthorized, "Not authorized to manage GoTrue SSO configuration"},
+		{"rate limited", adminclient.ErrRateLimited, "Request was rate limited by the GoTrue admin API"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := diagFromClientError(tt.err)
+			if len(diags) != 1 || diags[0].Summary != tt.wantSummary {
+				t.Fatalf("diagFromClientError(%v) = %+v, want summary %q", tt.err, diags, tt.wantSummary)
+			}
+		})
+	}
+}
+
+func TestDiagFromClientErrorFallsBackToFromErr(t *testing.T) {
+	err := errors.New("boom")
+
+	diags := diagFromClientError(err)
+	want := diag.FromErr(err)
+
+	if len(diags) != 1 || diags[0].Summary != want[0].Summary {
+		t.Fatalf("diagFromClientError(%v) = %+v, want %+v", err, diags, want)
+	}
+}
+
+func TestDiagFromReadErrorClearsStateOnNotFound(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSSODomain().Schema, map[string]interface{}{
+		"provider_id": "provider-id",
+		"domain":      "example.com",
+	})
+	d.SetId(ssoDomainID("provider-id", "example.com"))
+
+	diags := diagFromReadError(adminclient.ErrProviderNotFound, d)
+
+	if diags != nil {
+		t.Fatalf("expected no diagnostics, got %+v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Fatalf("expected resource to be dropped from state, id is %q", d.Id())
+	}
+}
+
+func TestDiagFromReadErrorDelegatesOtherErrors(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSSODomain().Schema, map[string]interface{}{
+		"provider_id": "provider-id",
+		"domain":      "example.com",
+	})
+	d.SetId(ssoDomainID("provider-id", "example.com"))
+
+	diags := diagFromReadError(adminclient.ErrUnauthorized, d)
+
+	if len(diags) != 1 || diags[0].Summary != "Not authorized to manage GoTrue SSO configuration" {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+
+	if d.Id() == "" {
+		t.Fatal("expected resource to remain in state for non-not-found errors")
+	}
+}
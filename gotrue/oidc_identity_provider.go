@@ -0,0 +1,357 @@
+package gotrue
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/supabase-community/terraform-provider-gotrue/adminclient"
+)
+
+func resourceOIDCIdentityProviderSet(provider *adminclient.IdentityProviderResponse, d *schema.ResourceData) diag.Diagnostics {
+	d.SetId(provider.ID)
+
+	if err := d.Set("issuer", provider.OIDC.Issuer); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("client_id", provider.OIDC.ClientID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("scopes", provider.OIDC.Scopes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("discovery_url", provider.OIDC.DiscoveryURL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("jwks_url", provider.OIDC.JWKSURL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("created_at", provider.CreatedAt.UTC().Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("updated_at", provider.UpdatedAt.UTC().Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var domains []string
+
+	for _, domain := range provider.Domains {
+		domains = append(domains, domain.Domain)
+	}
+
+	sort.Strings(domains)
+
+	domainsSet := schema.NewSet(schema.HashString, nil)
+	for _, domain := range domains {
+		domainsSet.Add(domain)
+	}
+
+	if err := d.Set("domains", domainsSet); err != nil {
+		return diag.FromErr(err)
+	}
+
+	keys, err := json.Marshal(provider.OIDC.AttributeMapping)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("attribute_mapping", string(keys)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceOIDCIdentityProviderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(adminclient.Client)
+
+	provider, err := client.GetIdentityProvider(ctx, d.Id())
+	if err != nil {
+		return diagFromReadError(err, d)
+	}
+
+	return resourceOIDCIdentityProviderSet(provider, d)
+}
+
+func resourceOIDCIdentityProviderCreateTemplate(d *schema.ResourceData) (*adminclient.IdentityProviderRequest, diag.Diagnostics) {
+	template := &adminclient.IdentityProviderRequest{}
+
+	template.OIDCIssuer = d.Get("issuer").(string)
+	template.OIDCClientID = d.Get("client_id").(string)
+	template.OIDCClientSecret = d.Get("client_secret").(string)
+
+	if scopes, ok := d.GetOk("scopes"); ok {
+		for _, scope := range scopes.([]interface{}) {
+			template.OIDCScopes = append(template.OIDCScopes, scope.(string))
+		}
+	}
+
+	if discoveryURL, ok := d.GetOk("discovery_url"); ok {
+		template.OIDCDiscoveryURL = discoveryURL.(string)
+	}
+
+	if jwksURL, ok := d.GetOk("jwks_url"); ok {
+		template.OIDCJWKSURL = jwksURL.(string)
+	}
+
+	if domainsSet, ok := d.GetOk("domains"); ok {
+		var domains []string
+
+		for _, domain := range domainsSet.(*schema.Set).List() {
+			domains = append(domains, domain.(string))
+		}
+
+		template.Domains = &domains
+	}
+
+	if keys, ok := d.GetOk("attribute_mapping"); ok && keys.(string) != "" {
+		if err := json.Unmarshal([]byte(keys.(string)), &template.AttributeMapping); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	return template, nil
+}
+
+// resourceOIDCIdentityProviderUpdateTemplate gates every field on
+// d.HasChange, unlike the create template's d.GetOk, so only changed fields
+// are filled in. A cleared domains set is still sent, since Domains is a
+// pointer; a cleared scopes, discovery_url or jwks_url is left out of the
+// PUT body, because those request fields are tagged omitempty.
+func resourceOIDCIdentityProviderUpdateTemplate(d *schema.ResourceData) (*adminclient.IdentityProviderRequest, diag.Diagnostics) {
+	template := &adminclient.IdentityProviderRequest{}
+
+	if d.HasChange("issuer") {
+		template.OIDCIssuer = d.Get("issuer").(string)
+	}
+
+	if d.HasChange("client_secret") {
+		template.OIDCClientSecret = d.Get("client_secret").(string)
+	}
+
+	if d.HasChange("scopes") {
+		for _, scope := range d.Get("scopes").([]interface{}) {
+			template.OIDCScopes = append(template.OIDCScopes, scope.(string))
+		}
+	}
+
+	if d.HasChange("discovery_url") {
+		template.OIDCDiscoveryURL = d.Get("discovery_url").(string)
+	}
+
+	if d.HasChange("jwks_url") {
+		template.OIDCJWKSURL = d.Get("jwks_url").(string)
+	}
+
+	if d.HasChange("domains") {
+		var domains []string
+
+		for _, domain := range d.Get("domains").(*schema.Set).List() {
+			domains = append(domains, domain.(string))
+		}
+
+		template.Domains = &domains
+	}
+
+	if d.HasChange("attribute_mapping") {
+		if keys, ok := d.GetOk("attribute_mapping"); ok && keys.(string) != "" {
+			if err := json.Unmarshal([]byte(keys.(string)), &template.AttributeMapping); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+	}
+
+	return template, nil
+}
+
+func resourceOIDCIdentityProviderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(adminclient.Client)
+
+	template, diags := resourceOIDCIdentityProviderCreateTemplate(d)
+	if diags != nil {
+		return diags
+	}
+
+	template.Type = "oidc"
+
+	provider, err := client.CreateIdentityProvider(ctx, template)
+	if err != nil {
+		return diagFromClientError(err)
+	}
+
+	return resourceOIDCIdentityProviderSet(provider, d)
+}
+
+func resourceOIDCIdentityProviderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(adminclient.Client)
+
+	template, diags := resourceOIDCIdentityProviderUpdateTemplate(d)
+	if diags != nil {
+		return diags
+	}
+
+	provider, err := client.UpdateIdentityProvider(ctx, d.Id(), template)
+	if err != nil {
+		return diagFromClientError(err)
+	}
+
+	return resourceOIDCIdentityProviderSet(provider, d)
+}
+
+func resourceOIDCIdentityProviderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(adminclient.Client)
+
+	if err := client.DeleteIdentityProvider(ctx, d.Id()); err != nil {
+		return diagFromClientError(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func dataSourceOIDCIdentityProviderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(adminclient.Client)
+
+	provider, diags := dataSourceFindIdentityProvider(ctx, client, d)
+	if diags != nil {
+		return diags
+	}
+
+	if err := d.Set("resource_id", provider.ResourceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceOIDCIdentityProviderSet(provider, d)
+}
+
+func dataSourceOIDCIdentityProvider() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOIDCIdentityProviderRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scopes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"discovery_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"jwks_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domains": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"attribute_mapping": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOIDCIdentityProvider() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOIDCIdentityProviderCreate,
+		ReadContext:   resourceOIDCIdentityProviderRead,
+		UpdateContext: resourceOIDCIdentityProviderUpdate,
+		DeleteContext: resourceOIDCIdentityProviderDelete,
+		Schema: map[string]*schema.Schema{
+			"issuer": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_secret": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"scopes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"discovery_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"jwks_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domains": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateDomain,
+				},
+			},
+			"attribute_mapping": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateAttributeMapping,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
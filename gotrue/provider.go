@@ -72,7 +72,7 @@ func resourceIdentityProviderRead(ctx context.Context, d *schema.ResourceData, m
 
 	provider, err := client.GetIdentityProvider(ctx, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromReadError(err, d)
 	}
 
 	return resourceIdentityProviderSet(provider, d)
@@ -90,9 +90,13 @@ func resourceIdentityProviderUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if d.HasChange("domains") {
+		var domains []string
+
 		for _, domain := range d.Get("domains").(*schema.Set).List() {
-			template.Domains = append(template.Domains, domain.(string))
+			domains = append(domains, domain.(string))
 		}
+
+		template.Domains = &domains
 	}
 
 	if d.HasChange("attribute_mapping") {
@@ -105,7 +109,7 @@ func resourceIdentityProviderUpdate(ctx context.Context, d *schema.ResourceData,
 
 	provider, err := client.UpdateIdentityProvider(ctx, d.Id(), template)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromClientError(err)
 	}
 
 	return resourceIdentityProviderSet(provider, d)
@@ -115,7 +119,7 @@ func resourceIdentityProviderDelete(ctx context.Context, d *schema.ResourceData,
 	client := m.(adminclient.Client)
 
 	if err := client.DeleteIdentityProvider(ctx, d.Id()); err != nil {
-		return diag.FromErr(err)
+		return diagFromClientError(err)
 	}
 
 	d.SetId("")
@@ -143,7 +147,7 @@ func resourceIdentityProviderCreate(ctx context.Context, d *schema.ResourceData,
 			domains = append(domains, domain.(string))
 		}
 
-		template.Domains = domains
+		template.Domains = &domains
 	}
 
 	if keys, ok := d.GetOk("attribute_mapping"); ok && keys.(string) != "" {
@@ -154,7 +158,7 @@ func resourceIdentityProviderCreate(ctx context.Context, d *schema.ResourceData,
 
 	provider, err := client.CreateIdentityProvider(ctx, template)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromClientError(err)
 	}
 
 	return resourceIdentityProviderSet(provider, d)
@@ -164,6 +168,55 @@ var (
 	domainPattern = regexp.MustCompile("^[a-z0-9-]+(.[a-z0-9-]+)*$")
 )
 
+func validateDomain(value interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !domainPattern.MatchString(value.(string)) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Value %q is not a valid domain", value.(string)),
+		})
+	}
+
+	return diags
+}
+
+func validateAttributeMapping(value interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var mapping adminclient.AttributeMapping
+
+	if err := json.Unmarshal([]byte(value.(string)), &mapping); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "attribute_mapping must be valid JSON",
+			Detail:   fmt.Sprintf("JSON parsing failed: %v", err.Error()),
+		})
+
+		return diags
+	}
+
+	for key, value := range mapping.Keys {
+		if value.Name == "" && len(value.Names) == 0 && value.Default == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Attribute mapping key %q must have at least one property set: name, names or default", key),
+			})
+		} else if len(value.Names) > 0 {
+			for i, name := range value.Names {
+				if name == "" {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  fmt.Sprintf("Attribute mapping name under %q.names at position %v is empty", key, i),
+					})
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
 func resourceIdentityProvider() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIdentityProviderCreate,
@@ -174,20 +227,10 @@ func resourceIdentityProvider() *schema.Resource {
 			"domains": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
-					ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
-						var diags diag.Diagnostics
-
-						if !domainPattern.MatchString(value.(string)) {
-							diags = append(diags, diag.Diagnostic{
-								Severity: diag.Error,
-								Summary:  fmt.Sprintf("Value %q is not a valid domain", value.(string)),
-							})
-						}
-
-						return diags
-					},
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateDomain,
 				},
 			},
 			"metadata_url": {
@@ -199,44 +242,69 @@ func resourceIdentityProvider() *schema.Resource {
 				Optional: true,
 			},
 			"attribute_mapping": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateAttributeMapping,
+			},
+			"created_at": {
 				Type:     schema.TypeString,
-				Optional: true,
-				ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
-					var diags diag.Diagnostics
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
 
-					var mapping adminclient.AttributeMapping
+func dataSourceIdentityProviderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(adminclient.Client)
 
-					if err := json.Unmarshal([]byte(value.(string)), &mapping); err != nil {
-						diags = append(diags, diag.Diagnostic{
-							Severity: diag.Error,
-							Summary:  "attribute_mapping must be valid JSON",
-							Detail:   fmt.Sprintf("JSON parsing failed: %v", err.Error()),
-						})
+	provider, diags := dataSourceFindIdentityProvider(ctx, client, d)
+	if diags != nil {
+		return diags
+	}
 
-						return diags
-					}
+	if err := d.Set("resource_id", provider.ResourceID); err != nil {
+		return diag.FromErr(err)
+	}
 
-					for key, value := range mapping.Keys {
-						if value.Name == "" && len(value.Names) == 0 && value.Default == nil {
-							diags = append(diags, diag.Diagnostic{
-								Severity: diag.Error,
-								Summary:  fmt.Sprintf("Attribute mapping key %q must have at least one property set: name, names or default", key),
-							})
-						} else if len(value.Names) > 0 {
-							for i, name := range value.Names {
-								if name == "" {
-									diags = append(diags, diag.Diagnostic{
-										Severity: diag.Error,
-										Summary:  fmt.Sprintf("Attribute mapping name under %q.names at position %v is empty", key, i),
-									})
-								}
-							}
-						}
-					}
+	return resourceIdentityProviderSet(provider, d)
+}
 
-					return diags
+func dataSourceIdentityProvider() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIdentityProviderRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"domains": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
 				},
 			},
+			"metadata_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata_xml": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attribute_mapping": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -317,6 +385,12 @@ func Provider() *schema.Provider {
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"gotrue_saml_identity_provider": resourceIdentityProvider(),
+			"gotrue_oidc_identity_provider": resourceOIDCIdentityProvider(),
+			"gotrue_sso_domain":             resourceSSODomain(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"gotrue_saml_identity_provider": dataSourceIdentityProvider(),
+			"gotrue_oidc_identity_provider": dataSourceOIDCIdentityProvider(),
 		},
 	}
 
@@ -0,0 +1,100 @@
+package gotrue
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/supabase-community/terraform-provider-gotrue/adminclient"
+)
+
+func ssoDomainID(providerID, domain string) string {
+	return providerID + "/" + domain
+}
+
+func resourceSSODomainSet(providerID string, domain *adminclient.Domain, d *schema.ResourceData) diag.Diagnostics {
+	d.SetId(ssoDomainID(providerID, domain.Domain))
+
+	if err := d.Set("provider_id", providerID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("domain", domain.Domain); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSSODomainCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(adminclient.Client)
+
+	providerID := d.Get("provider_id").(string)
+	domain := d.Get("domain").(string)
+
+	added, err := client.AddDomain(ctx, providerID, domain)
+	if err != nil {
+		return diagFromClientError(err)
+	}
+
+	return resourceSSODomainSet(providerID, added, d)
+}
+
+func resourceSSODomainRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(adminclient.Client)
+
+	providerID := d.Get("provider_id").(string)
+	domain := d.Get("domain").(string)
+
+	domains, err := client.ListDomains(ctx, providerID)
+	if err != nil {
+		return diagFromReadError(err, d)
+	}
+
+	for _, candidate := range domains {
+		if candidate.Domain == domain {
+			return resourceSSODomainSet(providerID, &candidate, d)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceSSODomainDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(adminclient.Client)
+
+	providerID := d.Get("provider_id").(string)
+	domain := d.Get("domain").(string)
+
+	if err := client.RemoveDomain(ctx, providerID, domain); err != nil {
+		return diagFromClientError(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceSSODomain() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSSODomainCreate,
+		ReadContext:   resourceSSODomainRead,
+		DeleteContext: resourceSSODomainDelete,
+		Schema: map[string]*schema.Schema{
+			"provider_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateDomain,
+			},
+		},
+	}
+}